@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
+)
+
+// ListModelsParams carries the filter, sort, and pagination options accepted
+// by CatalogSourceProvider.ListModels.
+type ListModelsParams struct {
+	// Query is a free-text search matched against name, description,
+	// provider, library name, and tasks.
+	Query string
+
+	OrderBy   model.OrderByField
+	SortOrder model.SortOrder
+
+	// Provider, LibraryName, and License filter on the corresponding
+	// CatalogModel facets using an exact (case-insensitive) match.
+	Provider    string
+	LibraryName string
+	License     string
+
+	// Tasks filters on CatalogModel.Tasks. By default a model matches if it
+	// has any of the listed tasks; set TasksMatchAll to require all of them.
+	Tasks         []string
+	TasksMatchAll bool
+
+	// CreatedAfter and UpdatedAfter, when set, exclude models whose create
+	// or last-update time is not strictly after the given instant.
+	CreatedAfter *time.Time
+	UpdatedAfter *time.Time
+
+	// PageSize bounds the number of items returned. NextPageToken, if set,
+	// resumes a previous ListModels call at the point it left off.
+	PageSize      int32
+	NextPageToken string
+}
+
+// CatalogSourceConfig describes a single configured catalog source, as read
+// from the catalog sources configuration file.
+type CatalogSourceConfig struct {
+	Name       string
+	Type       string
+	Properties map[string]any
+}
+
+// CatalogSourceProvider is implemented by each catalog backend (yaml,
+// huggingface, ...) and exposes read access to the models it knows about.
+type CatalogSourceProvider interface {
+	GetModel(ctx context.Context, name string) (*model.CatalogModel, error)
+	ListModels(ctx context.Context, params ListModelsParams) (model.CatalogModelList, error)
+	GetArtifacts(ctx context.Context, name string) (*model.CatalogModelArtifactList, error)
+}
+
+// CatalogSourceProviderFactory builds a CatalogSourceProvider from its
+// configuration.
+type CatalogSourceProviderFactory func(source *CatalogSourceConfig) (CatalogSourceProvider, error)
+
+var (
+	catalogTypesLock sync.RWMutex
+	catalogTypes     = map[string]CatalogSourceProviderFactory{}
+)
+
+// RegisterCatalogType registers a CatalogSourceProviderFactory under the
+// given type name, so it can be referenced by that name from catalog source
+// configs. It is expected to be called from the provider's init function.
+func RegisterCatalogType(name string, factory CatalogSourceProviderFactory) error {
+	catalogTypesLock.Lock()
+	defer catalogTypesLock.Unlock()
+
+	if _, exists := catalogTypes[name]; exists {
+		return fmt.Errorf("catalog type %q already registered", name)
+	}
+	catalogTypes[name] = factory
+	return nil
+}
+
+// NewCatalogSource builds the CatalogSourceProvider configured by source,
+// looking up the factory registered under source.Type.
+func NewCatalogSource(source *CatalogSourceConfig) (CatalogSourceProvider, error) {
+	catalogTypesLock.RLock()
+	factory, exists := catalogTypes[source.Type]
+	catalogTypesLock.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("unknown catalog source type %q", source.Type)
+	}
+	return factory(source)
+}
@@ -0,0 +1,407 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
+)
+
+const (
+	hfEndpoint     = "endpoint"
+	hfToken        = "token"
+	hfFilter       = "filter"
+	hfAuthor       = "author"
+	hfOrganization = "organization"
+	hfPageSize     = "pageSize"
+	hfMaxModels    = "maxModels"
+
+	defaultHFEndpoint  = "https://huggingface.co"
+	defaultHFPageSize  = 100
+	defaultHFMaxModels = 10000
+	hfCacheTTL         = 5 * time.Minute
+)
+
+// hfModelInfo is the subset of the Hugging Face Hub /api/models response
+// that huggingfaceCatalogImpl cares about.
+type hfModelInfo struct {
+	ID           string     `json:"id"`
+	Author       string     `json:"author"`
+	PipelineTag  string     `json:"pipeline_tag"`
+	LibraryName  string     `json:"library_name"`
+	Tags         []string   `json:"tags"`
+	LastModified string     `json:"lastModified"`
+	CreatedAt    string     `json:"createdAt"`
+	CardData     hfCardData `json:"cardData"`
+}
+
+type hfCardData struct {
+	License string `json:"license"`
+}
+
+type hfTreeEntry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+}
+
+// huggingfaceCatalogImpl implements CatalogSourceProvider against the
+// Hugging Face Hub HTTP API.
+type huggingfaceCatalogImpl struct {
+	endpoint     string
+	token        string
+	filter       string
+	author       string
+	organization string
+	pageSize     int
+	maxModels    int
+
+	httpClient *http.Client
+
+	cacheLock sync.RWMutex
+	cacheAt   time.Time
+	cache     map[string]*hfModelInfo
+}
+
+var _ CatalogSourceProvider = &huggingfaceCatalogImpl{}
+
+func (h *huggingfaceCatalogImpl) authorize(req *http.Request) {
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+}
+
+func (h *huggingfaceCatalogImpl) GetModel(ctx context.Context, name string) (*model.CatalogModel, error) {
+	info, err := h.fetchModel(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	cm := hfModelToCatalogModel(info)
+	return &cm, nil
+}
+
+func (h *huggingfaceCatalogImpl) fetchModel(ctx context.Context, name string) (*hfModelInfo, error) {
+	u := fmt.Sprintf("%s/api/models/%s", h.endpoint, name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.authorize(req)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("huggingface hub: unexpected status %d for %s", resp.StatusCode, u)
+	}
+
+	var info hfModelInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("huggingface hub: decode %s: %w", u, err)
+	}
+	return &info, nil
+}
+
+// refreshCache repopulates the model cache from the Hub's /api/models
+// listing endpoint if the TTL has elapsed, honoring the configured filter,
+// author/organization, and maxModels cap.
+func (h *huggingfaceCatalogImpl) refreshCache(ctx context.Context, query string) (map[string]*hfModelInfo, error) {
+	h.cacheLock.RLock()
+	if h.cache != nil && time.Since(h.cacheAt) < hfCacheTTL && query == "" {
+		cache := h.cache
+		h.cacheLock.RUnlock()
+		return cache, nil
+	}
+	h.cacheLock.RUnlock()
+
+	cache := make(map[string]*hfModelInfo)
+	author := h.author
+	if h.organization != "" {
+		author = h.organization
+	}
+
+	for offset := 0; offset < h.maxModels; offset += h.pageSize {
+		limit := h.pageSize
+		if offset+limit > h.maxModels {
+			limit = h.maxModels - offset
+		}
+
+		q := url.Values{}
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("full", "true")
+		if h.filter != "" {
+			q.Set("filter", h.filter)
+		}
+		if author != "" {
+			q.Set("author", author)
+		}
+		if query != "" {
+			q.Set("search", query)
+		}
+
+		u := fmt.Sprintf("%s/api/models?%s", h.endpoint, q.Encode())
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		h.authorize(req)
+
+		resp, err := h.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close() //nolint:errcheck
+			return nil, fmt.Errorf("huggingface hub: unexpected status %d for %s", resp.StatusCode, u)
+		}
+
+		var page []hfModelInfo
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close() //nolint:errcheck
+		if err != nil {
+			return nil, fmt.Errorf("huggingface hub: decode %s: %w", u, err)
+		}
+
+		for i := range page {
+			cache[page[i].ID] = &page[i]
+		}
+		if len(page) < limit {
+			break
+		}
+	}
+
+	if query == "" {
+		h.cacheLock.Lock()
+		h.cache = cache
+		h.cacheAt = time.Now()
+		h.cacheLock.Unlock()
+	}
+
+	return cache, nil
+}
+
+func (h *huggingfaceCatalogImpl) ListModels(ctx context.Context, params ListModelsParams) (model.CatalogModelList, error) {
+	cache, err := h.refreshCache(ctx, params.Query)
+	if err != nil {
+		return model.CatalogModelList{}, err
+	}
+
+	var items []*model.CatalogModel
+	for _, info := range cache {
+		cm := hfModelToCatalogModel(info)
+		if params.Query != "" && !matchesQuery(&cm, params.Query) {
+			continue
+		}
+		if params.Provider != "" && !strings.EqualFold(cm.GetProvider(), params.Provider) {
+			continue
+		}
+		if params.LibraryName != "" && !strings.EqualFold(cm.GetLibraryName(), params.LibraryName) {
+			continue
+		}
+		if params.License != "" && !strings.EqualFold(cm.GetLicense(), params.License) {
+			continue
+		}
+		if !matchesTasks(&cm, params.Tasks, params.TasksMatchAll) {
+			continue
+		}
+		if !matchesTimeWindow(cm.GetCreateTimeSinceEpoch(), params.CreatedAfter) {
+			continue
+		}
+		if !matchesTimeWindow(cm.GetLastUpdateTimeSinceEpoch(), params.UpdatedAfter) {
+			continue
+		}
+		items = append(items, &cm)
+	}
+
+	return sortAndPaginateModels(items, params)
+}
+
+func (h *huggingfaceCatalogImpl) GetArtifacts(ctx context.Context, name string) (*model.CatalogModelArtifactList, error) {
+	info, err := h.fetchModel(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+
+	revision := "main"
+	u := fmt.Sprintf("%s/api/models/%s/tree/%s", h.endpoint, name, revision)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	h.authorize(req)
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("huggingface hub: unexpected status %d for %s", resp.StatusCode, u)
+	}
+
+	var tree []hfTreeEntry
+	if err := json.NewDecoder(resp.Body).Decode(&tree); err != nil {
+		return nil, fmt.Errorf("huggingface hub: decode %s: %w", u, err)
+	}
+
+	var artifacts []model.CatalogModelArtifact
+	for _, entry := range tree {
+		if entry.Type != "file" || !isModelArtifact(entry.Path) {
+			continue
+		}
+		artifact := model.CatalogModelArtifact{}
+		artifact.Uri = fmt.Sprintf("%s/%s/resolve/%s/%s", h.endpoint, name, revision, entry.Path)
+		artifacts = append(artifacts, artifact)
+	}
+
+	count := len(artifacts)
+	return &model.CatalogModelArtifactList{
+		Items:    artifacts,
+		PageSize: int32(count),
+		Size:     int32(count),
+	}, nil
+}
+
+// isModelArtifact reports whether path looks like a model weights file we
+// should surface, rather than a README, config, or tokenizer file.
+func isModelArtifact(path string) bool {
+	for _, suffix := range []string{".safetensors", ".gguf", ".onnx", ".bin", ".pt", ".h5"} {
+		if strings.HasSuffix(path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func hfModelToCatalogModel(info *hfModelInfo) model.CatalogModel {
+	cm := model.CatalogModel{
+		Name: info.ID,
+	}
+	if info.PipelineTag != "" {
+		cm.Tasks = []string{info.PipelineTag}
+	}
+	if info.LibraryName != "" {
+		cm.LibraryName = &info.LibraryName
+	}
+	if info.CardData.License != "" {
+		cm.License = &info.CardData.License
+	}
+	if len(info.Tags) > 0 {
+		custom := make(map[string]model.MetadataValue, len(info.Tags))
+		for _, tag := range info.Tags {
+			custom[tag] = model.MetadataValue{}
+		}
+		cm.CustomProperties = &custom
+	}
+	if ms := parseHFTimestamp(info.LastModified); ms != "" {
+		cm.LastUpdateTimeSinceEpoch = &ms
+	}
+	if ms := parseHFTimestamp(info.CreatedAt); ms != "" {
+		cm.CreateTimeSinceEpoch = &ms
+	}
+	if info.Author != "" {
+		cm.Provider = &info.Author
+	}
+	return cm
+}
+
+// parseHFTimestamp converts a Hub RFC3339 timestamp to the
+// milliseconds-since-epoch string format used by CatalogModel.
+func parseHFTimestamp(ts string) string {
+	if ts == "" {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
+
+func newHuggingfaceCatalog(source *CatalogSourceConfig) (CatalogSourceProvider, error) {
+	endpoint, _ := source.Properties[hfEndpoint].(string)
+	if endpoint == "" {
+		endpoint = defaultHFEndpoint
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+
+	pageSize := defaultHFPageSize
+	if v, ok := source.Properties[hfPageSize]; ok {
+		if n, ok := toInt(v); ok && n > 0 {
+			pageSize = n
+		}
+	}
+
+	maxModels := defaultHFMaxModels
+	if v, ok := source.Properties[hfMaxModels]; ok {
+		if n, ok := toInt(v); ok && n > 0 {
+			maxModels = n
+		}
+	}
+
+	token, _ := source.Properties[hfToken].(string)
+	filter, _ := source.Properties[hfFilter].(string)
+	author, _ := source.Properties[hfAuthor].(string)
+	organization, _ := source.Properties[hfOrganization].(string)
+
+	h := &huggingfaceCatalogImpl{
+		endpoint:     endpoint,
+		token:        token,
+		filter:       filter,
+		author:       author,
+		organization: organization,
+		pageSize:     pageSize,
+		maxModels:    maxModels,
+		httpClient:   &http.Client{Timeout: 30 * time.Second},
+	}
+
+	if _, err := h.refreshCache(context.Background(), ""); err != nil {
+		glog.Errorf("unable to prime huggingface catalog cache: %v", err)
+		// Not fatal; the cache is refreshed lazily on the next ListModels call.
+	}
+
+	return h, nil
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
+
+func init() {
+	if err := RegisterCatalogType("huggingface", newHuggingfaceCatalog); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,168 @@
+package catalog
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/golang/glog"
+)
+
+// fileMonitor watches files and directories on disk with a single shared
+// fsnotify.Watcher and fans out change notifications to whoever is watching
+// a given path.
+type fileMonitor struct {
+	watcher *fsnotify.Watcher
+
+	mu       sync.Mutex
+	watchers map[string][]chan struct{} // exact file paths
+	dirs     map[string][]chan struct{} // directory roots, watched recursively
+}
+
+func newFileMonitor() (*fileMonitor, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	m := &fileMonitor{
+		watcher:  watcher,
+		watchers: make(map[string][]chan struct{}),
+		dirs:     make(map[string][]chan struct{}),
+	}
+	go m.run()
+	return m, nil
+}
+
+func (m *fileMonitor) run() {
+	for {
+		select {
+		case event, ok := <-m.watcher.Events:
+			if !ok {
+				return
+			}
+			m.handleEvent(event)
+		case err, ok := <-m.watcher.Errors:
+			if !ok {
+				return
+			}
+			glog.Errorf("file monitor error: %v", err)
+		}
+	}
+}
+
+// handleEvent notifies anyone watching the changed path, and, for creates
+// under a watched directory root, starts watching newly created
+// subdirectories so the watch stays recursive.
+func (m *fileMonitor) handleEvent(event fsnotify.Event) {
+	m.mu.Lock()
+
+	if event.Op&fsnotify.Create != 0 {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			for root := range m.dirs {
+				if m.underRoot(root, event.Name) {
+					if err := m.watcher.Add(event.Name); err != nil {
+						glog.Errorf("unable to watch new directory %s: %v", event.Name, err)
+					}
+				}
+			}
+		}
+	}
+
+	var targets []chan struct{}
+	targets = append(targets, m.watchers[event.Name]...)
+	for root, chans := range m.dirs {
+		if m.underRoot(root, event.Name) {
+			targets = append(targets, chans...)
+		}
+	}
+
+	m.mu.Unlock()
+
+	for _, ch := range targets {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (m *fileMonitor) underRoot(root, path string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// Path returns a channel that receives a value whenever the file at path
+// changes. The returned channel is never closed.
+func (m *fileMonitor) Path(path string) (<-chan struct{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.watcher == nil {
+		return nil, errors.New("file monitor is not running")
+	}
+	if _, exists := m.watchers[path]; !exists {
+		if err := m.watcher.Add(path); err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan struct{}, 1)
+	m.watchers[path] = append(m.watchers[path], ch)
+	return ch, nil
+}
+
+// Dir returns a channel that receives a value whenever a file or directory
+// under root is created, renamed, removed, or modified. The whole tree
+// rooted at root is watched, including subdirectories created afterwards.
+// The returned channel is never closed.
+func (m *fileMonitor) Dir(root string) (<-chan struct{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.watcher == nil {
+		return nil, errors.New("file monitor is not running")
+	}
+	if _, exists := m.dirs[root]; !exists {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return m.watcher.Add(path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan struct{}, 1)
+	m.dirs[root] = append(m.dirs[root], ch)
+	return ch, nil
+}
+
+var (
+	monitorOnce sync.Once
+	monitor     *fileMonitor
+	monitorErr  error
+)
+
+// getMonitor returns the process-wide fileMonitor, creating it on first use.
+func getMonitor() *fileMonitor {
+	monitorOnce.Do(func() {
+		monitor, monitorErr = newFileMonitor()
+		if monitorErr != nil {
+			glog.Errorf("unable to start file monitor: %v", monitorErr)
+			monitor = &fileMonitor{
+				watchers: make(map[string][]chan struct{}),
+				dirs:     make(map[string][]chan struct{}),
+			}
+		}
+	})
+	return monitor
+}
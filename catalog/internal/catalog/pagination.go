@@ -0,0 +1,171 @@
+package catalog
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
+)
+
+// catalogPageCursor is the decoded form of a ListModels NextPageToken. It
+// records the sort key and name of the last item of the previous page, so
+// pagination stays stable across reloads as long as OrderBy and SortOrder
+// don't change between calls. Every CatalogSourceProvider shares this
+// cursor format via sortAndPaginateModels, so they paginate consistently.
+type catalogPageCursor struct {
+	SortKey string `json:"sortKey"`
+	Name    string `json:"name"`
+}
+
+func encodePageToken(c catalogPageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.URLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string) (catalogPageCursor, error) {
+	var c catalogPageCursor
+	b, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("invalid page token: %w", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid page token: %w", err)
+	}
+	return c, nil
+}
+
+func sortKeyFor(cm *model.CatalogModel, orderBy model.OrderByField) string {
+	switch orderBy {
+	case model.ORDERBYFIELD_CREATE_TIME:
+		return cm.GetCreateTimeSinceEpoch()
+	case model.ORDERBYFIELD_LAST_UPDATE_TIME:
+		return cm.GetLastUpdateTimeSinceEpoch()
+	case model.ORDERBYFIELD_NAME:
+		fallthrough
+	default:
+		return cm.Name
+	}
+}
+
+// sortAndPaginateModels orders models per params.OrderBy/SortOrder, ties
+// broken by Name, then returns the page starting at params.NextPageToken
+// (or the start, if empty) of up to params.PageSize items. Every
+// CatalogSourceProvider.ListModels implementation should filter its models
+// and finish by delegating here, so they all paginate the same way.
+func sortAndPaginateModels(models []*model.CatalogModel, params ListModelsParams) (model.CatalogModelList, error) {
+	totalSize := len(models)
+
+	// Ties on the primary OrderBy key break on Name, since the page-token
+	// cursor and the sort.Search below both assume the slice is strictly
+	// ordered by (key, name); without this, two models sharing a sort key
+	// (e.g. the same CreateTimeSinceEpoch, or the same Name once merged
+	// from two sources) would leave sort.Search's monotonicity assumption
+	// unsatisfied.
+	sort.Slice(models, func(i, j int) bool {
+		a := models[i]
+		b := models[j]
+
+		var less bool
+		switch params.OrderBy {
+		case model.ORDERBYFIELD_CREATE_TIME:
+			aTime, _ := strconv.ParseInt(a.GetCreateTimeSinceEpoch(), 10, 64)
+			bTime, _ := strconv.ParseInt(b.GetCreateTimeSinceEpoch(), 10, 64)
+			if aTime == bTime {
+				less = strings.Compare(a.Name, b.Name) < 0
+			} else {
+				less = aTime < bTime
+			}
+		case model.ORDERBYFIELD_LAST_UPDATE_TIME:
+			aTime, _ := strconv.ParseInt(a.GetLastUpdateTimeSinceEpoch(), 10, 64)
+			bTime, _ := strconv.ParseInt(b.GetLastUpdateTimeSinceEpoch(), 10, 64)
+			if aTime == bTime {
+				less = strings.Compare(a.Name, b.Name) < 0
+			} else {
+				less = aTime < bTime
+			}
+		case model.ORDERBYFIELD_NAME:
+			fallthrough
+		default:
+			less = strings.Compare(a.Name, b.Name) < 0
+		}
+
+		if params.SortOrder == model.SORTORDER_DESC {
+			return !less
+		}
+		return less
+	})
+
+	start := 0
+	if params.NextPageToken != "" {
+		cursor, err := decodePageToken(params.NextPageToken)
+		if err != nil {
+			return model.CatalogModelList{}, err
+		}
+		start = sort.Search(len(models), func(i int) bool {
+			cm := models[i]
+			key := sortKeyFor(cm, params.OrderBy)
+			// Compare keys the way the sort above does: numerically for
+			// the time fields, as strings for names.
+			cmp := strings.Compare(key, cursor.SortKey)
+			if params.OrderBy == model.ORDERBYFIELD_CREATE_TIME || params.OrderBy == model.ORDERBYFIELD_LAST_UPDATE_TIME {
+				keyTime, _ := strconv.ParseInt(key, 10, 64)
+				cursorTime, _ := strconv.ParseInt(cursor.SortKey, 10, 64)
+				switch {
+				case keyTime < cursorTime:
+					cmp = -1
+				case keyTime > cursorTime:
+					cmp = 1
+				default:
+					cmp = 0
+				}
+			}
+			if cmp == 0 {
+				if params.SortOrder == model.SORTORDER_DESC {
+					return strings.Compare(cm.Name, cursor.Name) < 0
+				}
+				return strings.Compare(cm.Name, cursor.Name) > 0
+			}
+			if params.SortOrder == model.SORTORDER_DESC {
+				return cmp < 0
+			}
+			return cmp > 0
+		})
+	}
+
+	pageSize := int(params.PageSize)
+	if pageSize <= 0 {
+		pageSize = len(models) - start
+	}
+
+	end := start
+	if start < len(models) {
+		end = start + pageSize
+		if end > len(models) {
+			end = len(models)
+		}
+	}
+
+	page := models[start:end]
+
+	list := model.CatalogModelList{
+		Items:     make([]model.CatalogModel, len(page)),
+		PageSize:  int32(len(page)),
+		Size:      int32(len(page)),
+		TotalSize: int32(totalSize),
+	}
+	for i := range list.Items {
+		list.Items[i] = *page[i]
+	}
+	if end < len(models) {
+		last := models[end-1]
+		list.NextPageToken = encodePageToken(catalogPageCursor{
+			SortKey: sortKeyFor(last, params.OrderBy),
+			Name:    last.Name,
+		})
+	}
+	return list, nil
+}
@@ -6,10 +6,10 @@ import (
 	"math"
 	"os"
 	"path/filepath"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/yaml"
 
@@ -46,6 +46,56 @@ func (y *yamlCatalogImpl) GetModel(ctx context.Context, name string) (*model.Cat
 	return &cp, nil
 }
 
+func matchesQuery(cm *model.CatalogModel, query string) bool {
+	if query == "" {
+		return true
+	}
+	query = strings.ToLower(query)
+	if strings.Contains(strings.ToLower(cm.Name), query) ||
+		strings.Contains(strings.ToLower(cm.GetDescription()), query) ||
+		strings.Contains(strings.ToLower(cm.GetProvider()), query) ||
+		strings.Contains(strings.ToLower(cm.GetLibraryName()), query) {
+		return true
+	}
+	for _, task := range cm.GetTasks() {
+		if strings.Contains(strings.ToLower(task), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesTasks(cm *model.CatalogModel, tasks []string, matchAll bool) bool {
+	if len(tasks) == 0 {
+		return true
+	}
+	have := make(map[string]bool, len(cm.GetTasks()))
+	for _, t := range cm.GetTasks() {
+		have[strings.ToLower(t)] = true
+	}
+	for _, want := range tasks {
+		found := have[strings.ToLower(want)]
+		if matchAll && !found {
+			return false
+		}
+		if !matchAll && found {
+			return true
+		}
+	}
+	return matchAll
+}
+
+func matchesTimeWindow(epoch string, after *time.Time) bool {
+	if after == nil {
+		return true
+	}
+	ms, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return false
+	}
+	return time.UnixMilli(ms).After(*after)
+}
+
 func (y *yamlCatalogImpl) ListModels(ctx context.Context, params ListModelsParams) (model.CatalogModelList, error) {
 	y.modelsLock.RLock()
 	defer y.modelsLock.RUnlock()
@@ -53,76 +103,33 @@ func (y *yamlCatalogImpl) ListModels(ctx context.Context, params ListModelsParam
 	var filteredModels []*model.CatalogModel
 	for _, ym := range y.models {
 		cm := ym.CatalogModel
-		if params.Query != "" {
-			query := strings.ToLower(params.Query)
-			// Check if query matches name, description, tasks, provider, or libraryName
-			if !strings.Contains(strings.ToLower(cm.Name), query) &&
-				!strings.Contains(strings.ToLower(cm.GetDescription()), query) &&
-				!strings.Contains(strings.ToLower(cm.GetProvider()), query) &&
-				!strings.Contains(strings.ToLower(cm.GetLibraryName()), query) {
-
-				// Check tasks
-				foundInTasks := false
-				for _, task := range cm.GetTasks() { // Use GetTasks() for nil safety
-					if strings.Contains(strings.ToLower(task), query) {
-						foundInTasks = true
-						break
-					}
-				}
-				if !foundInTasks {
-					continue // Skip if no match in any searchable field
-				}
-			}
-		}
-		filteredModels = append(filteredModels, &cm)
-	}
 
-	// Sort the filtered models
-	sort.Slice(filteredModels, func(i, j int) bool {
-		a := filteredModels[i]
-		b := filteredModels[j]
-
-		var less bool
-		switch params.OrderBy {
-		case model.ORDERBYFIELD_CREATE_TIME:
-			// Convert CreateTimeSinceEpoch (string) to int64 for comparison
-			// Handle potential nil or conversion errors by treating as 0
-			aTime, _ := strconv.ParseInt(a.GetCreateTimeSinceEpoch(), 10, 64)
-			bTime, _ := strconv.ParseInt(b.GetCreateTimeSinceEpoch(), 10, 64)
-			less = aTime < bTime
-		case model.ORDERBYFIELD_LAST_UPDATE_TIME:
-			// Convert LastUpdateTimeSinceEpoch (string) to int64 for comparison
-			// Handle potential nil or conversion errors by treating as 0
-			aTime, _ := strconv.ParseInt(a.GetLastUpdateTimeSinceEpoch(), 10, 64)
-			bTime, _ := strconv.ParseInt(b.GetLastUpdateTimeSinceEpoch(), 10, 64)
-			less = aTime < bTime
-		case model.ORDERBYFIELD_NAME:
-			fallthrough
-		default:
-			// Fallback to name sort if an unknown sort field is provided
-			less = strings.Compare(a.Name, b.Name) < 0
-		}
-
-		if params.SortOrder == model.SORTORDER_DESC {
-			return !less
-		}
-		return less
-	})
+		if !matchesQuery(&cm, params.Query) {
+			continue
+		}
+		if params.Provider != "" && !strings.EqualFold(cm.GetProvider(), params.Provider) {
+			continue
+		}
+		if params.LibraryName != "" && !strings.EqualFold(cm.GetLibraryName(), params.LibraryName) {
+			continue
+		}
+		if params.License != "" && !strings.EqualFold(cm.GetLicense(), params.License) {
+			continue
+		}
+		if !matchesTasks(&cm, params.Tasks, params.TasksMatchAll) {
+			continue
+		}
+		if !matchesTimeWindow(cm.GetCreateTimeSinceEpoch(), params.CreatedAfter) {
+			continue
+		}
+		if !matchesTimeWindow(cm.GetLastUpdateTimeSinceEpoch(), params.UpdatedAfter) {
+			continue
+		}
 
-	count := len(filteredModels)
-	if count > math.MaxInt32 {
-		count = math.MaxInt32
+		filteredModels = append(filteredModels, &cm)
 	}
 
-	list := model.CatalogModelList{
-		Items:    make([]model.CatalogModel, count),
-		PageSize: int32(count),
-		Size:     int32(count),
-	}
-	for i := range list.Items {
-		list.Items[i] = *filteredModels[i]
-	}
-	return list, nil // Return the struct value directly
+	return sortAndPaginateModels(filteredModels, params)
 }
 
 func (y *yamlCatalogImpl) GetArtifacts(ctx context.Context, name string) (*model.CatalogModelArtifactList, error) {
@@ -150,35 +157,173 @@ func (y *yamlCatalogImpl) GetArtifacts(ctx context.Context, name string) (*model
 	return &list, nil
 }
 
-func (y *yamlCatalogImpl) load(path string) error {
+// loadFile parses a single YAML catalog file and merges its models into
+// dst. When namespaced is true (a directory load, merging multiple files)
+// models are keyed by "<source>/<name>" so that models with the same name
+// from different files don't collide; a single-file load keys by the bare
+// model name, as it always has, so GetModel/GetArtifacts lookups by name
+// keep working.
+func loadFile(path string, dst map[string]*yamlModel, namespaced bool) error {
 	bytes, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to read %s file: %v", yamlCatalogPath, err)
+		return fmt.Errorf("failed to read %s: %v", path, err)
 	}
 
 	var contents yamlCatalog
 	if err = yaml.UnmarshalStrict(bytes, &contents); err != nil {
-		return fmt.Errorf("failed to parse %s file: %v", yamlCatalogPath, err)
+		return fmt.Errorf("failed to parse %s: %v", path, err)
 	}
 
-	models := make(map[string]*yamlModel, len(contents.Models))
 	for i := range contents.Models {
-		models[contents.Models[i].Name] = &contents.Models[i]
+		ym := &contents.Models[i]
+		key := ym.Name
+		if namespaced {
+			key = contents.Source + "/" + ym.Name
+		}
+		dst[key] = ym
+	}
+
+	return nil
+}
+
+// load rebuilds the model map from scratch by reading every file in paths,
+// then swaps it in atomically. A parse error in one file is logged and
+// skipped; it does not discard models already loaded from the other files.
+// namespaced must be true for a directory load and false for a single-file
+// load; see loadFile.
+func (y *yamlCatalogImpl) load(paths []string, namespaced bool) error {
+	models := make(map[string]*yamlModel)
+
+	var firstErr error
+	for _, path := range paths {
+		if err := loadFile(path, models, namespaced); err != nil {
+			glog.Errorf("unable to load YAML catalog file %s: %v", path, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
 	}
 
 	y.modelsLock.Lock()
-	defer y.modelsLock.Unlock()
 	y.models = models
+	y.modelsLock.Unlock()
 
+	if len(models) == 0 && firstErr != nil {
+		return firstErr
+	}
 	return nil
 }
 
-const yamlCatalogPath = "yamlCatalogPath"
+const (
+	yamlCatalogPath = "yamlCatalogPath"
+	yamlCatalogDir  = "yamlCatalogDir"
+	yamlCatalogGlob = "glob"
+
+	defaultYamlCatalogGlob = "**/*.yaml"
+)
+
+// globMatch reports whether the slash-separated path matches pattern, where
+// a "**" path segment matches zero or more path segments.
+func globMatch(pattern, path string) bool {
+	return globMatchParts(strings.Split(pattern, "/"), strings.Split(filepath.ToSlash(path), "/"))
+}
+
+func globMatchParts(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		if globMatchParts(pattern[1:], path) {
+			return true
+		}
+		if len(path) == 0 {
+			return false
+		}
+		return globMatchParts(pattern, path[1:])
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if ok, err := filepath.Match(pattern[0], path[0]); err != nil || !ok {
+		return false
+	}
+	return globMatchParts(pattern[1:], path[1:])
+}
+
+// findYamlFiles walks dir and returns every file whose path relative to dir
+// matches glob.
+func findYamlFiles(dir, glob string) ([]string, error) {
+	var matches []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if globMatch(glob, rel) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	return matches, err
+}
 
 func newYamlCatalog(source *CatalogSourceConfig) (CatalogSourceProvider, error) {
-	yamlModelFile, exists := source.Properties[yamlCatalogPath].(string)
-	if !exists || yamlModelFile == "" {
-		return nil, fmt.Errorf("missing %s string property", yamlCatalogPath)
+	yamlModelFile, hasFile := source.Properties[yamlCatalogPath].(string)
+	yamlModelDir, hasDir := source.Properties[yamlCatalogDir].(string)
+
+	if (!hasFile || yamlModelFile == "") && (!hasDir || yamlModelDir == "") {
+		return nil, fmt.Errorf("missing %s or %s string property", yamlCatalogPath, yamlCatalogDir)
+	}
+	if hasFile && yamlModelFile != "" && hasDir && yamlModelDir != "" {
+		return nil, fmt.Errorf("%s and %s are mutually exclusive", yamlCatalogPath, yamlCatalogDir)
+	}
+
+	p := &yamlCatalogImpl{}
+
+	if hasDir && yamlModelDir != "" {
+		glob := defaultYamlCatalogGlob
+		if g, ok := source.Properties[yamlCatalogGlob].(string); ok && g != "" {
+			glob = g
+		}
+
+		yamlModelDir, err := filepath.Abs(yamlModelDir)
+		if err != nil {
+			return nil, fmt.Errorf("abs: %w", err)
+		}
+
+		reload := func() {
+			paths, err := findYamlFiles(yamlModelDir, glob)
+			if err != nil {
+				glog.Errorf("unable to list YAML catalog directory %s: %v", yamlModelDir, err)
+				return
+			}
+			if err := p.load(paths, true); err != nil {
+				glog.Errorf("unable to load YAML catalog directory %s: %v", yamlModelDir, err)
+			}
+		}
+		reload()
+
+		go func() {
+			changes, err := getMonitor().Dir(yamlModelDir)
+			if err != nil {
+				glog.Errorf("unable to watch YAML catalog directory: %v", err)
+				// Not fatal, we just won't get automatic updates.
+			}
+
+			for range changes {
+				glog.Infof("Reloading YAML catalog directory %s", yamlModelDir)
+				reload()
+			}
+		}()
+
+		return p, nil
 	}
 
 	yamlModelFile, err := filepath.Abs(yamlModelFile)
@@ -186,8 +331,7 @@ func newYamlCatalog(source *CatalogSourceConfig) (CatalogSourceProvider, error)
 		return nil, fmt.Errorf("abs: %w", err)
 	}
 
-	p := &yamlCatalogImpl{}
-	err = p.load(yamlModelFile)
+	err = p.load([]string{yamlModelFile}, false)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +346,7 @@ func newYamlCatalog(source *CatalogSourceConfig) (CatalogSourceProvider, error)
 		for range changes {
 			glog.Infof("Reloading YAML catalog %s", yamlModelFile)
 
-			err = p.load(yamlModelFile)
+			err = p.load([]string{yamlModelFile}, false)
 			if err != nil {
 				glog.Errorf("unable to load YAML catalog: %v", err)
 			}
@@ -0,0 +1,86 @@
+package catalog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
+)
+
+const testYamlCatalog = `
+source: test-source
+models:
+  - name: my-model
+    provider: test-provider
+`
+
+// TestYamlCatalogGetModelSingleFile guards against the single-file load
+// path keying its model map by a source-qualified name: GetModel/
+// GetArtifacts must still be reachable by the bare model name, as they
+// always were before directory loading was added.
+func TestYamlCatalogGetModelSingleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testYamlCatalog), 0o600))
+
+	p := &yamlCatalogImpl{}
+	require.NoError(t, p.load([]string{path}, false))
+
+	got, err := p.GetModel(context.Background(), "my-model")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "my-model", got.Name)
+
+	artifacts, err := p.GetArtifacts(context.Background(), "my-model")
+	require.NoError(t, err)
+	require.NotNil(t, artifacts)
+}
+
+const testYamlCatalogMulti = `
+source: test-source
+models:
+  - name: model-a
+    provider: test-provider
+  - name: model-b
+    provider: test-provider
+  - name: model-c
+    provider: test-provider
+`
+
+// TestYamlCatalogListModelsDescPagination guards against sortAndPaginateModels
+// re-including the last item of a page as the first item of the next: each
+// NextPageToken is built from the last item returned, so the cursor lookup
+// for the following page must resume strictly after it, not at-or-after it.
+// It pages through SORTORDER_DESC one item at a time.
+func TestYamlCatalogListModelsDescPagination(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testYamlCatalogMulti), 0o600))
+
+	p := &yamlCatalogImpl{}
+	require.NoError(t, p.load([]string{path}, false))
+
+	var names []string
+	token := ""
+	for {
+		list, err := p.ListModels(context.Background(), ListModelsParams{
+			OrderBy:       model.ORDERBYFIELD_NAME,
+			SortOrder:     model.SORTORDER_DESC,
+			PageSize:      1,
+			NextPageToken: token,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		names = append(names, list.Items[0].Name)
+
+		if list.NextPageToken == "" {
+			break
+		}
+		token = list.NextPageToken
+	}
+
+	assert.Equal(t, []string{"model-c", "model-b", "model-a"}, names)
+}
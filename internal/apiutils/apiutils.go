@@ -0,0 +1,10 @@
+// Package apiutils holds small generic helpers shared across the registry's
+// internal packages.
+package apiutils
+
+// Of returns a pointer to a copy of v, for building optional struct fields
+// (most API and model types use pointers to distinguish "unset" from the
+// zero value) without a throwaway local variable.
+func Of[T any](v T) *T {
+	return &v
+}
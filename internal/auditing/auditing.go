@@ -0,0 +1,65 @@
+// Package auditing records who did what to the registry and when, so that
+// mutations can be reconstructed and searched after the fact.
+package auditing
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Operation identifies the kind of mutation an Entry records.
+type Operation string
+
+const (
+	OperationCreate Operation = "CREATE"
+	OperationUpdate Operation = "UPDATE"
+	OperationDelete Operation = "DELETE"
+)
+
+// Entry is a single audited mutation.
+type Entry struct {
+	Timestamp time.Time
+
+	// Actor is the user or service account that performed the operation,
+	// as resolved from the request context.
+	Actor string
+
+	Operation Operation
+
+	// ResourceKind, ResourceID, and ResourceName identify what was
+	// mutated, e.g. ("RegisteredModel", "42", "my-model").
+	ResourceKind string
+	ResourceID   string
+	ResourceName string
+
+	Request  json.RawMessage
+	Response json.RawMessage
+
+	StatusCode int
+	Latency    time.Duration
+
+	TraceID   string
+	RequestID string
+}
+
+// Query filters a Search call.
+type Query struct {
+	From, To time.Time
+
+	Actor        string
+	ResourceKind string
+	Operation    Operation
+
+	// Limit caps the number of entries returned; Search backends should
+	// apply a sane default when Limit is zero.
+	Limit int
+}
+
+// Auditor indexes and searches audit Entries. Implementations must be safe
+// for concurrent use.
+type Auditor interface {
+	Index(ctx context.Context, entry Entry) error
+	Search(ctx context.Context, query Query) ([]Entry, error)
+	Close() error
+}
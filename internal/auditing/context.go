@@ -0,0 +1,19 @@
+package auditing
+
+import "context"
+
+type actorContextKey struct{}
+
+// WithActor returns a context that ActorFromContext will resolve to actor.
+// Request-handling code should call this once it has authenticated the
+// caller, so the interceptor can attribute the resulting Entry correctly.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor set by WithActor, or "" if none was
+// set.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorContextKey{}).(string)
+	return actor
+}
@@ -0,0 +1,138 @@
+package auditing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// operationForMethod maps a gRPC method name's conventional prefix to an
+// Operation, e.g. "/.../CreateRegisteredModel" -> OperationCreate.
+func operationForMethod(method string) Operation {
+	name := method
+	if idx := strings.LastIndex(method, "/"); idx != -1 {
+		name = method[idx+1:]
+	}
+	switch {
+	case strings.HasPrefix(name, "Create"):
+		return OperationCreate
+	case strings.HasPrefix(name, "Update"), strings.HasPrefix(name, "Upsert"), strings.HasPrefix(name, "Patch"):
+		return OperationUpdate
+	case strings.HasPrefix(name, "Delete"):
+		return OperationDelete
+	default:
+		return ""
+	}
+}
+
+func resourceKindForMethod(method string) string {
+	name := method
+	if idx := strings.LastIndex(method, "/"); idx != -1 {
+		name = method[idx+1:]
+	}
+	for _, prefix := range []string{"Create", "Update", "Upsert", "Patch", "Delete"} {
+		if strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+	return name
+}
+
+// UnaryServerInterceptor records an Entry in auditor for every gRPC call
+// whose method looks like a mutation (Create/Update/Delete). Other calls
+// pass through unaudited.
+func UnaryServerInterceptor(auditor Auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		op := operationForMethod(info.FullMethod)
+		if op == "" {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		latency := time.Since(start)
+
+		entry := Entry{
+			Timestamp:    start,
+			Actor:        ActorFromContext(ctx),
+			Operation:    op,
+			ResourceKind: resourceKindForMethod(info.FullMethod),
+			StatusCode:   int(status.Code(err)),
+			Latency:      latency,
+		}
+		if reqJSON, marshalErr := json.Marshal(req); marshalErr == nil {
+			entry.Request = reqJSON
+		}
+		if err == nil {
+			if respJSON, marshalErr := json.Marshal(resp); marshalErr == nil {
+				entry.Response = respJSON
+			}
+		}
+
+		if auditErr := auditor.Index(ctx, entry); auditErr != nil {
+			glog.Errorf("unable to index audit entry for %s: %v", info.FullMethod, auditErr)
+		}
+
+		return resp, err
+	}
+}
+
+// HTTPMiddleware records an Entry in auditor for every HTTP request whose
+// method implies a mutation (POST/PUT/PATCH/DELETE).
+func HTTPMiddleware(auditor Auditor) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op := operationForHTTPMethod(r.Method)
+			if op == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			entry := Entry{
+				Timestamp:    start,
+				Actor:        ActorFromContext(r.Context()),
+				Operation:    op,
+				ResourceKind: strings.Trim(r.URL.Path, "/"),
+				StatusCode:   rec.status,
+				Latency:      time.Since(start),
+				RequestID:    r.Header.Get("X-Request-Id"),
+			}
+			if auditErr := auditor.Index(r.Context(), entry); auditErr != nil {
+				glog.Errorf("unable to index audit entry for %s %s: %v", r.Method, r.URL.Path, auditErr)
+			}
+		})
+	}
+}
+
+func operationForHTTPMethod(method string) Operation {
+	switch method {
+	case http.MethodPost:
+		return OperationCreate
+	case http.MethodPut, http.MethodPatch:
+		return OperationUpdate
+	case http.MethodDelete:
+		return OperationDelete
+	default:
+		return ""
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,58 @@
+package auditing
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// Resource is implemented by repository-layer models that can describe
+// themselves for an audit Entry.
+type Resource interface {
+	GetID() *int32
+	ResourceKind() string
+	ResourceName() string
+}
+
+// RecordSave indexes a CREATE or UPDATE Entry for a Save call, choosing the
+// operation based on whether the caller passed an ID in (an update) or got
+// one back for the first time (a create).
+func RecordSave(ctx context.Context, auditor Auditor, before, after Resource, err error) {
+	op := OperationCreate
+	if before != nil && before.GetID() != nil {
+		op = OperationUpdate
+	}
+	record(ctx, auditor, op, after, err)
+}
+
+// RecordDelete indexes a DELETE Entry for a Delete call.
+func RecordDelete(ctx context.Context, auditor Auditor, resource Resource, err error) {
+	record(ctx, auditor, OperationDelete, resource, err)
+}
+
+func record(ctx context.Context, auditor Auditor, op Operation, resource Resource, opErr error) {
+	if auditor == nil || resource == nil {
+		return
+	}
+
+	entry := Entry{
+		Timestamp:    time.Now(),
+		Actor:        ActorFromContext(ctx),
+		Operation:    op,
+		ResourceKind: resource.ResourceKind(),
+		ResourceName: resource.ResourceName(),
+	}
+	if id := resource.GetID(); id != nil {
+		entry.ResourceID = strconv.FormatInt(int64(*id), 10)
+	}
+	if opErr != nil {
+		entry.StatusCode = 500
+	} else {
+		entry.StatusCode = 200
+	}
+
+	if err := auditor.Index(ctx, entry); err != nil {
+		// Auditing failures must never fail the mutation they describe.
+		_ = err
+	}
+}
@@ -0,0 +1,189 @@
+// Package timescale implements auditing.Auditor on top of TimescaleDB,
+// storing entries in a hypertable partitioned on their timestamp.
+package timescale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/kubeflow/model-registry/internal/auditing"
+)
+
+// Auditor is a TimescaleDB-backed auditing.Auditor.
+type Auditor struct {
+	pool *pgxpool.Pool
+}
+
+var _ auditing.Auditor = &Auditor{}
+
+// New opens a pgx pool against dsn, creates the audit_entries table if it
+// doesn't exist, and converts it into a hypertable on the timestamp column.
+func New(ctx context.Context, dsn string) (*Auditor, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("connect to timescaledb: %w", err)
+	}
+
+	a := &Auditor{pool: pool}
+	if err := a.ensureSchema(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *Auditor) ensureSchema(ctx context.Context) error {
+	_, err := a.pool.Exec(ctx, `
+CREATE TABLE IF NOT EXISTS audit_entries (
+	timestamp     TIMESTAMPTZ NOT NULL,
+	actor         TEXT NOT NULL,
+	operation     TEXT NOT NULL,
+	resource_kind TEXT NOT NULL,
+	resource_id   TEXT NOT NULL,
+	resource_name TEXT NOT NULL,
+	request       JSONB,
+	response      JSONB,
+	status_code   INT NOT NULL,
+	latency_ms    BIGINT NOT NULL,
+	trace_id      TEXT,
+	request_id    TEXT
+)`)
+	if err != nil {
+		return fmt.Errorf("create audit_entries table: %w", err)
+	}
+
+	_, err = a.pool.Exec(ctx, `SELECT create_hypertable('audit_entries', 'timestamp', if_not_exists => TRUE)`)
+	if err != nil {
+		return fmt.Errorf("create audit_entries hypertable: %w", err)
+	}
+	return nil
+}
+
+// Index inserts entry into the audit_entries hypertable.
+func (a *Auditor) Index(ctx context.Context, entry auditing.Entry) error {
+	_, err := a.pool.Exec(ctx, `
+INSERT INTO audit_entries
+	(timestamp, actor, operation, resource_kind, resource_id, resource_name,
+	 request, response, status_code, latency_ms, trace_id, request_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		entry.Timestamp, entry.Actor, string(entry.Operation), entry.ResourceKind,
+		entry.ResourceID, entry.ResourceName, nullableJSON(entry.Request), nullableJSON(entry.Response),
+		entry.StatusCode, entry.Latency.Milliseconds(), entry.TraceID, entry.RequestID)
+	if err != nil {
+		return fmt.Errorf("index audit entry: %w", err)
+	}
+	return nil
+}
+
+// Search returns entries matching query, most recent first.
+func (a *Auditor) Search(ctx context.Context, query auditing.Query) ([]auditing.Entry, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	sql := `
+SELECT timestamp, actor, operation, resource_kind, resource_id, resource_name,
+       request, response, status_code, latency_ms, trace_id, request_id
+FROM audit_entries
+WHERE ($1::timestamptz IS NULL OR timestamp >= $1)
+  AND ($2::timestamptz IS NULL OR timestamp <= $2)
+  AND ($3 = '' OR actor = $3)
+  AND ($4 = '' OR resource_kind = $4)
+  AND ($5 = '' OR operation = $5)
+ORDER BY timestamp DESC
+LIMIT $6`
+
+	rows, err := a.pool.Query(ctx, sql, nullableTime(query.From), nullableTime(query.To),
+		query.Actor, query.ResourceKind, string(query.Operation), limit)
+	if err != nil {
+		return nil, fmt.Errorf("search audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []auditing.Entry
+	for rows.Next() {
+		var e auditing.Entry
+		var operation string
+		var latencyMS int64
+		var request, response []byte
+
+		if err := rows.Scan(&e.Timestamp, &e.Actor, &operation, &e.ResourceKind, &e.ResourceID,
+			&e.ResourceName, &request, &response, &e.StatusCode, &latencyMS, &e.TraceID, &e.RequestID); err != nil {
+			return nil, fmt.Errorf("scan audit entry: %w", err)
+		}
+
+		e.Operation = auditing.Operation(operation)
+		e.Latency = time.Duration(latencyMS) * time.Millisecond
+		e.Request = json.RawMessage(request)
+		e.Response = json.RawMessage(response)
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// Bucket is one row of a time-bucketed aggregation, as used by the
+// /audit/stats endpoint.
+type Bucket struct {
+	Start     time.Time
+	Operation auditing.Operation
+	Count     int64
+}
+
+// Stats aggregates entries matching query into buckets of the given
+// duration using Timescale's time_bucket function.
+func (a *Auditor) Stats(ctx context.Context, query auditing.Query, bucket time.Duration) ([]Bucket, error) {
+	sql := `
+SELECT time_bucket($1::interval, timestamp) AS bucket, operation, count(*)
+FROM audit_entries
+WHERE ($2::timestamptz IS NULL OR timestamp >= $2)
+  AND ($3::timestamptz IS NULL OR timestamp <= $3)
+  AND ($4 = '' OR actor = $4)
+  AND ($5 = '' OR resource_kind = $5)
+  AND ($6 = '' OR operation = $6)
+GROUP BY bucket, operation
+ORDER BY bucket DESC`
+
+	rows, err := a.pool.Query(ctx, sql, bucket.String(), nullableTime(query.From), nullableTime(query.To),
+		query.Actor, query.ResourceKind, string(query.Operation))
+	if err != nil {
+		return nil, fmt.Errorf("aggregate audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	var buckets []Bucket
+	for rows.Next() {
+		var b Bucket
+		var operation string
+		if err := rows.Scan(&b.Start, &operation, &b.Count); err != nil {
+			return nil, fmt.Errorf("scan audit bucket: %w", err)
+		}
+		b.Operation = auditing.Operation(operation)
+		buckets = append(buckets, b)
+	}
+	return buckets, rows.Err()
+}
+
+// Close releases the underlying connection pool.
+func (a *Auditor) Close() error {
+	a.pool.Close()
+	return nil
+}
+
+func nullableJSON(raw json.RawMessage) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	return []byte(raw)
+}
+
+func nullableTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
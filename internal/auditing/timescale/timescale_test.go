@@ -0,0 +1,111 @@
+package timescale_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/kubeflow/model-registry/internal/auditing"
+	"github.com/kubeflow/model-registry/internal/auditing/timescale"
+)
+
+var sharedAuditor *timescale.Auditor
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	req := testcontainers.ContainerRequest{
+		Image:        "timescale/timescaledb:latest-pg16",
+		ExposedPorts: []string{"5432/tcp"},
+		Env: map[string]string{
+			"POSTGRES_USER":     "postgres",
+			"POSTGRES_PASSWORD": "postgres",
+			"POSTGRES_DB":       "audit",
+		},
+		WaitingFor: wait.ForListeningPort("5432/tcp"),
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		panic("Failed to start TimescaleDB container: " + err.Error())
+	}
+
+	defer func() {
+		if sharedAuditor != nil {
+			sharedAuditor.Close() //nolint:errcheck
+		}
+		testcontainers.TerminateContainer(container) //nolint:errcheck
+	}()
+
+	host, err := container.Host(ctx)
+	if err != nil {
+		panic("Failed to get TimescaleDB host: " + err.Error())
+	}
+	port, err := container.MappedPort(ctx, "5432")
+	if err != nil {
+		panic("Failed to get TimescaleDB port: " + err.Error())
+	}
+
+	dsn := "postgres://postgres:postgres@" + host + ":" + port.Port() + "/audit?sslmode=disable"
+
+	sharedAuditor, err = timescale.New(ctx, dsn)
+	if err != nil {
+		panic("Failed to connect to TimescaleDB: " + err.Error())
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestAuditorIndexAndSearch(t *testing.T) {
+	ctx := context.Background()
+
+	entry := auditing.Entry{
+		Timestamp:    time.Now().UTC(),
+		Actor:        "user:alice",
+		Operation:    auditing.OperationCreate,
+		ResourceKind: "RegisteredModel",
+		ResourceID:   "42",
+		ResourceName: "test-model",
+		StatusCode:   200,
+		Latency:      15 * time.Millisecond,
+	}
+
+	require.NoError(t, sharedAuditor.Index(ctx, entry))
+
+	results, err := sharedAuditor.Search(ctx, auditing.Query{
+		Actor:        "user:alice",
+		ResourceKind: "RegisteredModel",
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "test-model", results[0].ResourceName)
+	assert.Equal(t, auditing.OperationCreate, results[0].Operation)
+}
+
+func TestAuditorStats(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, sharedAuditor.Index(ctx, auditing.Entry{
+		Timestamp:    time.Now().UTC(),
+		Actor:        "user:bob",
+		Operation:    auditing.OperationDelete,
+		ResourceKind: "RegisteredModel",
+		ResourceID:   "7",
+		ResourceName: "other-model",
+		StatusCode:   200,
+	}))
+
+	buckets, err := sharedAuditor.Stats(ctx, auditing.Query{ResourceKind: "RegisteredModel"}, time.Hour)
+	require.NoError(t, err)
+	assert.NotEmpty(t, buckets)
+}
@@ -0,0 +1,132 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/kubeflow/model-registry/internal/defaults"
+)
+
+// MySQLMigrator applies the embedmd schema to a MySQL or MariaDB database.
+// It detects which flavor it's talking to so it can branch on the handful
+// of statements that aren't portable between the two.
+type MySQLMigrator struct {
+	db     *gorm.DB
+	flavor Flavor
+}
+
+// NewMySQLMigrator builds a migrator for db, detecting whether it's talking
+// to MySQL or MariaDB by inspecting SELECT VERSION().
+func NewMySQLMigrator(db *gorm.DB) (*MySQLMigrator, error) {
+	flavor, err := detectFlavor(db)
+	if err != nil {
+		return nil, fmt.Errorf("detect database flavor: %w", err)
+	}
+	return &MySQLMigrator{db: db, flavor: flavor}, nil
+}
+
+func detectFlavor(db *gorm.DB) (Flavor, error) {
+	var version string
+	if err := db.Raw("SELECT VERSION()").Scan(&version).Error; err != nil {
+		return "", err
+	}
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		return FlavorMariaDB, nil
+	}
+	return FlavorMySQL, nil
+}
+
+// Migrate creates or upgrades the embedmd schema. The bulk of the DDL is
+// identical across flavors; the table collation (Flavor.defaultCollation),
+// rowFormatClause, and jsonDefaultClause are the spots that diverge.
+func (m *MySQLMigrator) Migrate() error {
+	collation := m.flavor.defaultCollation()
+	rowFormat := m.rowFormatClause()
+
+	typeStmt := fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS Type (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  properties JSON NULL %s,
+  UNIQUE KEY idx_type_name (name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=%s %s
+`, m.jsonDefaultClause(), collation, rowFormat)
+
+	if err := m.db.Exec(typeStmt).Error; err != nil {
+		return fmt.Errorf("migrate Type table: %w", err)
+	}
+
+	contextStmt := fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS Context (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  type_id INT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  external_id VARCHAR(255) NULL,
+  create_time_since_epoch BIGINT NOT NULL DEFAULT 0,
+  last_update_time_since_epoch BIGINT NOT NULL DEFAULT 0,
+  UNIQUE KEY idx_context_type_name (type_id, name),
+  CONSTRAINT fk_context_type FOREIGN KEY (type_id) REFERENCES Type (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=%s %s
+`, collation, rowFormat)
+
+	if err := m.db.Exec(contextStmt).Error; err != nil {
+		return fmt.Errorf("migrate Context table: %w", err)
+	}
+
+	contextPropertyStmt := fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS ContextProperty (
+  context_id INT NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  is_custom_property BOOL NOT NULL DEFAULT FALSE,
+  string_value LONGTEXT NULL,
+  int_value INT NULL,
+  PRIMARY KEY (context_id, name, is_custom_property),
+  CONSTRAINT fk_context_property_context FOREIGN KEY (context_id) REFERENCES Context (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=%s %s
+`, collation, rowFormat)
+
+	if err := m.db.Exec(contextPropertyStmt).Error; err != nil {
+		return fmt.Errorf("migrate ContextProperty table: %w", err)
+	}
+
+	if err := m.seedTypes(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// seedTypes inserts the well-known MLMD Type rows the registry relies on
+// existing, e.g. the "kf.RegisteredModel" type every RegisteredModel
+// Context is stamped with. It's idempotent: re-running Migrate against an
+// already-seeded database is a no-op here.
+func (m *MySQLMigrator) seedTypes() error {
+	for _, name := range []string{defaults.RegisteredModelTypeName} {
+		stmt := "INSERT IGNORE INTO Type (name) VALUES (?)"
+		if err := m.db.Exec(stmt, name).Error; err != nil {
+			return fmt.Errorf("seed Type %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// jsonDefaultClause returns a column default for the nullable JSON
+// properties column. MySQL 8 allows JSON columns to declare a literal
+// DEFAULT; MariaDB's JSON type is a LONGTEXT alias and rejects one.
+func (m *MySQLMigrator) jsonDefaultClause() string {
+	if m.flavor == FlavorMariaDB {
+		return ""
+	}
+	return "DEFAULT (JSON_OBJECT())"
+}
+
+// rowFormatClause pins ROW_FORMAT=DYNAMIC on MySQL so JSON/TEXT columns can
+// use off-page storage; MariaDB infers this from its own defaults and
+// rejects the explicit clause on some configurations.
+func (m *MySQLMigrator) rowFormatClause() string {
+	if m.flavor == FlavorMariaDB {
+		return ""
+	}
+	return "ROW_FORMAT=DYNAMIC"
+}
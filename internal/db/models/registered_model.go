@@ -0,0 +1,72 @@
+// Package models defines the repository-facing view of the registry's MLMD
+// entities, independent of how they're stored.
+package models
+
+// Properties is a single property or custom property attached to an
+// entity. Exactly one of the *Value fields is set.
+type Properties struct {
+	Name        string
+	StringValue *string
+	IntValue    *int32
+}
+
+// RegisteredModelAttributes holds the first-class (non-property) fields of
+// a RegisteredModel.
+type RegisteredModelAttributes struct {
+	Name                     *string
+	ExternalID               *string
+	CreateTimeSinceEpoch     *int64
+	LastUpdateTimeSinceEpoch *int64
+}
+
+// RegisteredModel is the repository-facing view of a registered model. The
+// concrete type is RegisteredModelImpl; callers interact with the
+// interface so the repository layer can return rows it loaded without
+// exposing its storage details.
+type RegisteredModel interface {
+	GetID() *int32
+	GetAttributes() *RegisteredModelAttributes
+	GetProperties() *[]Properties
+	GetCustomProperties() *[]Properties
+}
+
+// RegisteredModelImpl is the concrete RegisteredModel implementation.
+type RegisteredModelImpl struct {
+	ID               *int32
+	TypeID           *int32
+	Attributes       *RegisteredModelAttributes
+	Properties       *[]Properties
+	CustomProperties *[]Properties
+}
+
+func (m *RegisteredModelImpl) GetID() *int32 { return m.ID }
+
+func (m *RegisteredModelImpl) GetAttributes() *RegisteredModelAttributes { return m.Attributes }
+
+func (m *RegisteredModelImpl) GetProperties() *[]Properties { return m.Properties }
+
+func (m *RegisteredModelImpl) GetCustomProperties() *[]Properties { return m.CustomProperties }
+
+// Pagination carries the sort and page-size options shared by every List
+// call in the repository layer.
+type Pagination struct {
+	OrderBy       *string
+	SortOrder     *string
+	PageSize      *int32
+	NextPageToken *string
+}
+
+// RegisteredModelListOptions filters and paginates a RegisteredModel List
+// call.
+type RegisteredModelListOptions struct {
+	Pagination
+
+	Name       *string
+	ExternalID *string
+}
+
+// RegisteredModelList is a page of RegisteredModel results.
+type RegisteredModelList struct {
+	Items         []RegisteredModel
+	NextPageToken string
+}
@@ -0,0 +1,37 @@
+// Package schema defines the gorm row types backing the registry's MLMD
+// tables.
+package schema
+
+// Type is a row in the MLMD Type table, e.g. the "kf.RegisteredModel" type
+// that every RegisteredModel Context is stamped with.
+type Type struct {
+	ID   int32 `gorm:"primaryKey"`
+	Name string
+}
+
+func (Type) TableName() string { return "Type" }
+
+// Context is a row in the MLMD Context table. RegisteredModel is stored as
+// a Context whose TypeID points at the "kf.RegisteredModel" Type.
+type Context struct {
+	ID                       int32 `gorm:"primaryKey"`
+	TypeID                   int32
+	Name                     string
+	ExternalID               *string
+	CreateTimeSinceEpoch     int64
+	LastUpdateTimeSinceEpoch int64
+}
+
+func (Context) TableName() string { return "Context" }
+
+// ContextProperty is a row in the MLMD ContextProperty table, holding one
+// property or custom property of a Context.
+type ContextProperty struct {
+	ContextID        int32 `gorm:"primaryKey"`
+	Name             string `gorm:"primaryKey"`
+	IsCustomProperty bool   `gorm:"primaryKey"`
+	StringValue      *string
+	IntValue         *int32
+}
+
+func (ContextProperty) TableName() string { return "ContextProperty" }
@@ -0,0 +1,277 @@
+// Package service implements the registry's repository layer: CRUD and
+// listing over the MLMD tables, one repository type per entity.
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/kubeflow/model-registry/internal/auditing"
+	"github.com/kubeflow/model-registry/internal/db/models"
+	"github.com/kubeflow/model-registry/internal/db/schema"
+)
+
+func nowMillis() int64 { return time.Now().UnixMilli() }
+
+// RegisteredModelRepository stores RegisteredModels as MLMD Contexts of
+// typeID, with their properties in ContextProperty.
+type RegisteredModelRepository struct {
+	db      *gorm.DB
+	typeID  int64
+	auditor auditing.Auditor
+}
+
+// NewRegisteredModelRepository builds a RegisteredModelRepository. Mutations
+// are not audited; use NewAuditedRegisteredModelRepository to also record
+// them through an auditing.Auditor.
+func NewRegisteredModelRepository(db *gorm.DB, typeID int64) *RegisteredModelRepository {
+	return NewAuditedRegisteredModelRepository(db, typeID, auditing.NewNoop())
+}
+
+// NewAuditedRegisteredModelRepository builds a RegisteredModelRepository
+// whose Save and Delete calls each produce an auditing.Entry in auditor.
+func NewAuditedRegisteredModelRepository(db *gorm.DB, typeID int64, auditor auditing.Auditor) *RegisteredModelRepository {
+	return &RegisteredModelRepository{db: db, typeID: typeID, auditor: auditor}
+}
+
+// auditResource adapts a loaded RegisteredModel to auditing.Resource.
+type auditResource struct {
+	models.RegisteredModel
+}
+
+func (r auditResource) ResourceKind() string { return "RegisteredModel" }
+
+func (r auditResource) ResourceName() string {
+	if r.RegisteredModel == nil || r.GetAttributes() == nil || r.GetAttributes().Name == nil {
+		return ""
+	}
+	return *r.GetAttributes().Name
+}
+
+func (r auditResource) GetID() *int32 {
+	if r.RegisteredModel == nil {
+		return nil
+	}
+	return r.RegisteredModel.GetID()
+}
+
+// Save creates registeredModel if it has no ID, or updates the existing row
+// otherwise. Both paths replace the stored properties and custom
+// properties wholesale, and produce a CREATE or UPDATE audit entry.
+func (r *RegisteredModelRepository) Save(registeredModel models.RegisteredModel) (models.RegisteredModel, error) {
+	before := auditResource{registeredModel}
+
+	saved, err := r.save(registeredModel)
+
+	var after auditing.Resource = before
+	if saved != nil {
+		after = auditResource{saved}
+	}
+	auditing.RecordSave(context.Background(), r.auditor, before, after, err)
+
+	return saved, err
+}
+
+func (r *RegisteredModelRepository) save(registeredModel models.RegisteredModel) (models.RegisteredModel, error) {
+	attrs := registeredModel.GetAttributes()
+	if attrs == nil || attrs.Name == nil {
+		return nil, fmt.Errorf("registered model name is required")
+	}
+
+	var ctx schema.Context
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := nowMillis()
+
+		if registeredModel.GetID() != nil {
+			if err := tx.First(&ctx, "id = ? AND type_id = ?", *registeredModel.GetID(), r.typeID).Error; err != nil {
+				return fmt.Errorf("find registered model %d: %w", *registeredModel.GetID(), err)
+			}
+			ctx.Name = *attrs.Name
+			ctx.ExternalID = attrs.ExternalID
+			ctx.LastUpdateTimeSinceEpoch = now
+			if err := tx.Save(&ctx).Error; err != nil {
+				return fmt.Errorf("update registered model %d: %w", ctx.ID, err)
+			}
+		} else {
+			ctx = schema.Context{
+				TypeID:                   int32(r.typeID),
+				Name:                     *attrs.Name,
+				ExternalID:               attrs.ExternalID,
+				CreateTimeSinceEpoch:     now,
+				LastUpdateTimeSinceEpoch: now,
+			}
+			if err := tx.Create(&ctx).Error; err != nil {
+				return fmt.Errorf("create registered model: %w", err)
+			}
+		}
+
+		if err := tx.Where("context_id = ?", ctx.ID).Delete(&schema.ContextProperty{}).Error; err != nil {
+			return fmt.Errorf("clear properties for registered model %d: %w", ctx.ID, err)
+		}
+
+		var rows []schema.ContextProperty
+		rows = append(rows, propertyRows(ctx.ID, registeredModel.GetProperties(), false)...)
+		rows = append(rows, propertyRows(ctx.ID, registeredModel.GetCustomProperties(), true)...)
+		if len(rows) > 0 {
+			if err := tx.Create(&rows).Error; err != nil {
+				return fmt.Errorf("save properties for registered model %d: %w", ctx.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx.ID)
+}
+
+func propertyRows(contextID int32, props *[]models.Properties, custom bool) []schema.ContextProperty {
+	if props == nil {
+		return nil
+	}
+	rows := make([]schema.ContextProperty, 0, len(*props))
+	for _, p := range *props {
+		rows = append(rows, schema.ContextProperty{
+			ContextID:        contextID,
+			Name:             p.Name,
+			IsCustomProperty: custom,
+			StringValue:      p.StringValue,
+			IntValue:         p.IntValue,
+		})
+	}
+	return rows
+}
+
+// GetByID returns the RegisteredModel with the given ID.
+func (r *RegisteredModelRepository) GetByID(id int32) (models.RegisteredModel, error) {
+	var ctx schema.Context
+	if err := r.db.First(&ctx, "id = ? AND type_id = ?", id, r.typeID).Error; err != nil {
+		return nil, fmt.Errorf("get registered model %d: %w", id, err)
+	}
+
+	var props []schema.ContextProperty
+	if err := r.db.Where("context_id = ?", ctx.ID).Find(&props).Error; err != nil {
+		return nil, fmt.Errorf("get properties for registered model %d: %w", ctx.ID, err)
+	}
+
+	return contextToModel(ctx, props), nil
+}
+
+// Delete removes the RegisteredModel with the given ID and its properties,
+// and produces a DELETE audit entry.
+func (r *RegisteredModelRepository) Delete(id int32) error {
+	existing, getErr := r.GetByID(id)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("context_id = ?", id).Delete(&schema.ContextProperty{}).Error; err != nil {
+			return fmt.Errorf("delete properties for registered model %d: %w", id, err)
+		}
+		if err := tx.Delete(&schema.Context{}, "id = ? AND type_id = ?", id, r.typeID).Error; err != nil {
+			return fmt.Errorf("delete registered model %d: %w", id, err)
+		}
+		return nil
+	})
+
+	var resource auditing.Resource
+	if getErr == nil {
+		resource = auditResource{existing}
+	} else {
+		idCopy := id
+		resource = auditResource{&models.RegisteredModelImpl{ID: &idCopy}}
+	}
+	auditing.RecordDelete(context.Background(), r.auditor, resource, err)
+
+	return err
+}
+
+// List returns RegisteredModels matching options, ordered accordingly and
+// capped at options.PageSize. options.NextPageToken is not read, and the
+// returned list's NextPageToken is always empty.
+func (r *RegisteredModelRepository) List(options models.RegisteredModelListOptions) (*models.RegisteredModelList, error) {
+	q := r.db.Model(&schema.Context{}).Where("type_id = ?", r.typeID)
+
+	if options.Name != nil {
+		q = q.Where("name = ?", *options.Name)
+	}
+	if options.ExternalID != nil {
+		q = q.Where("external_id = ?", *options.ExternalID)
+	}
+
+	q = q.Order(orderClause(options.Pagination))
+
+	if options.PageSize != nil && *options.PageSize > 0 {
+		q = q.Limit(int(*options.PageSize))
+	}
+
+	var rows []schema.Context
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list registered models: %w", err)
+	}
+
+	items := make([]models.RegisteredModel, 0, len(rows))
+	for _, ctx := range rows {
+		var props []schema.ContextProperty
+		if err := r.db.Where("context_id = ?", ctx.ID).Find(&props).Error; err != nil {
+			return nil, fmt.Errorf("get properties for registered model %d: %w", ctx.ID, err)
+		}
+		items = append(items, contextToModel(ctx, props))
+	}
+
+	return &models.RegisteredModelList{Items: items}, nil
+}
+
+func orderClause(p models.Pagination) string {
+	column := "id"
+	if p.OrderBy != nil {
+		switch strings.ToUpper(*p.OrderBy) {
+		case "CREATE_TIME":
+			column = "create_time_since_epoch"
+		case "LAST_UPDATE_TIME":
+			column = "last_update_time_since_epoch"
+		case "NAME":
+			column = "name"
+		case "ID":
+			column = "id"
+		}
+	}
+
+	direction := "ASC"
+	if p.SortOrder != nil && strings.EqualFold(*p.SortOrder, "DESC") {
+		direction = "DESC"
+	}
+	return column + " " + direction
+}
+
+func contextToModel(ctx schema.Context, props []schema.ContextProperty) *models.RegisteredModelImpl {
+	var properties, customProperties []models.Properties
+	for _, p := range props {
+		prop := models.Properties{Name: p.Name, StringValue: p.StringValue, IntValue: p.IntValue}
+		if p.IsCustomProperty {
+			customProperties = append(customProperties, prop)
+		} else {
+			properties = append(properties, prop)
+		}
+	}
+
+	id := ctx.ID
+	createTime := ctx.CreateTimeSinceEpoch
+	updateTime := ctx.LastUpdateTimeSinceEpoch
+
+	return &models.RegisteredModelImpl{
+		ID: &id,
+		Attributes: &models.RegisteredModelAttributes{
+			Name:                     &ctx.Name,
+			ExternalID:               ctx.ExternalID,
+			CreateTimeSinceEpoch:     &createTime,
+			LastUpdateTimeSinceEpoch: &updateTime,
+		},
+		Properties:       &properties,
+		CustomProperties: &customProperties,
+	}
+}
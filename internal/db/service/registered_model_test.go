@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/kubeflow/model-registry/internal/apiutils"
+	"github.com/kubeflow/model-registry/internal/auditing"
 	"github.com/kubeflow/model-registry/internal/datastore/embedmd/mysql"
 	"github.com/kubeflow/model-registry/internal/db/models"
 	"github.com/kubeflow/model-registry/internal/db/schema"
@@ -27,13 +28,49 @@ var (
 	mysqlContainer *cont_mysql.MySQLContainer
 )
 
+// testDBFlavorEnv, if set, restricts TestMain to a single flavor instead of
+// running the full suite against every flavor in testDBFlavors. It's an
+// escape hatch for local iteration, not how the suite runs by default.
+const testDBFlavorEnv = "MODEL_REGISTRY_TEST_DB_FLAVOR"
+
+// testDBFlavors is every backend TestMain runs the repository suite
+// against, so a MariaDB-only regression can't slip by unnoticed the way an
+// opt-in env var would.
+var testDBFlavors = []mysql.Flavor{mysql.FlavorMySQL, mysql.FlavorMariaDB}
+
+func flavorImage(flavor mysql.Flavor) string {
+	if flavor == mysql.FlavorMariaDB {
+		return "mariadb:11"
+	}
+	return "mysql:8"
+}
+
 func TestMain(m *testing.M) {
+	flavors := testDBFlavors
+	if only := os.Getenv(testDBFlavorEnv); only != "" {
+		flavors = []mysql.Flavor{mysql.Flavor(only)}
+	}
+
+	code := 0
+	for _, flavor := range flavors {
+		if c := runRepositorySuite(m, flavor); c != 0 {
+			code = c
+		}
+	}
+
+	os.Exit(code)
+}
+
+// runRepositorySuite starts a container for flavor, points the
+// package-level sharedDB at it, runs the full *testing.M suite, and tears
+// the container down again before returning.
+func runRepositorySuite(m *testing.M, flavor mysql.Flavor) int {
 	ctx := context.Background()
 
-	// Create MySQL container once for all tests
+	// Create the database container for this flavor
 	container, err := cont_mysql.Run(
 		ctx,
-		"mysql:8",
+		flavorImage(flavor),
 		cont_mysql.WithUsername("root"),
 		cont_mysql.WithPassword("root"),
 		cont_mysql.WithDatabase("test"),
@@ -44,7 +81,7 @@ func TestMain(m *testing.M) {
 		}),
 	)
 	if err != nil {
-		panic("Failed to start MySQL container: " + err.Error())
+		panic("Failed to start " + string(flavor) + " container: " + err.Error())
 	}
 	mysqlContainer = container
 
@@ -58,10 +95,17 @@ func TestMain(m *testing.M) {
 		if mysqlContainer != nil {
 			testcontainers.TerminateContainer(mysqlContainer) //nolint:errcheck
 		}
+		mysqlContainer = nil
+		sharedDB = nil
 	}()
 
 	// Connect to the database
-	dbConnector := mysql.NewMySQLDBConnector(mysqlContainer.MustConnectionString(ctx), &_tls.TLSConfig{})
+	var dbConnector *mysql.MySQLDBConnector
+	if flavor == mysql.FlavorMariaDB {
+		dbConnector = mysql.NewMariaDBDBConnector(mysqlContainer.MustConnectionString(ctx), &_tls.TLSConfig{})
+	} else {
+		dbConnector = mysql.NewMySQLDBConnector(mysqlContainer.MustConnectionString(ctx), &_tls.TLSConfig{})
+	}
 	sharedDB, err = dbConnector.Connect()
 	if err != nil {
 		panic("Failed to connect to database: " + err.Error())
@@ -77,10 +121,8 @@ func TestMain(m *testing.M) {
 		panic("Failed to migrate database: " + err.Error())
 	}
 
-	// Run all tests
-	code := m.Run()
-
-	os.Exit(code)
+	// Run the full suite against this flavor
+	return m.Run()
 }
 
 func cleanupTestData(t *testing.T, db *gorm.DB) {
@@ -388,4 +430,45 @@ func TestRegisteredModelRepository(t *testing.T) {
 		assert.NotNil(t, retrieved.GetCustomProperties())
 		assert.Len(t, *retrieved.GetCustomProperties(), 2)
 	})
+
+	t.Run("TestAuditWiring", func(t *testing.T) {
+		recorder := &recordingAuditor{}
+		auditedRepo := service.NewAuditedRegisteredModelRepository(sharedDB, typeID, recorder)
+
+		registeredModel := &models.RegisteredModelImpl{
+			TypeID: apiutils.Of(int32(typeID)),
+			Attributes: &models.RegisteredModelAttributes{
+				Name: apiutils.Of("audit-test-model"),
+			},
+		}
+
+		saved, err := auditedRepo.Save(registeredModel)
+		require.NoError(t, err)
+
+		err = auditedRepo.Delete(*saved.GetID())
+		require.NoError(t, err)
+
+		require.Len(t, recorder.entries, 2)
+		assert.Equal(t, auditing.OperationCreate, recorder.entries[0].Operation)
+		assert.Equal(t, "audit-test-model", recorder.entries[0].ResourceName)
+		assert.Equal(t, auditing.OperationDelete, recorder.entries[1].Operation)
+	})
 }
+
+// recordingAuditor is a test-only auditing.Auditor that captures every
+// entry it's given, so tests can assert a repository call actually
+// produced one.
+type recordingAuditor struct {
+	entries []auditing.Entry
+}
+
+func (r *recordingAuditor) Index(ctx context.Context, entry auditing.Entry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *recordingAuditor) Search(ctx context.Context, query auditing.Query) ([]auditing.Entry, error) {
+	return r.entries, nil
+}
+
+func (r *recordingAuditor) Close() error { return nil }
@@ -0,0 +1,7 @@
+// Package defaults holds well-known names the registry relies on when
+// bootstrapping its MLMD type system.
+package defaults
+
+// RegisteredModelTypeName is the MLMD Context type name used to store
+// RegisteredModel entities.
+const RegisteredModelTypeName = "kf.RegisteredModel"
@@ -0,0 +1,16 @@
+// Package tls holds the TLS settings used when connecting to backing
+// datastores, independent of which datastore driver is in use.
+package tls
+
+// TLSConfig describes the certificate material and verification mode to use
+// when opening a TLS connection to a datastore. A zero-value TLSConfig
+// disables TLS.
+type TLSConfig struct {
+	Enabled    bool
+	CertPath   string
+	KeyPath    string
+	RootCAPath string
+	// InsecureSkipVerify disables server certificate verification. It must
+	// only be used for local development.
+	InsecureSkipVerify bool
+}